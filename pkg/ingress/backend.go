@@ -0,0 +1,173 @@
+package ingress
+
+import (
+	networkingV1 "k8s.io/api/networking/v1"
+	networkingV1beta1 "k8s.io/api/networking/v1beta1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/openservicemesh/osm/pkg/service"
+)
+
+// IngressPathMatchType describes how IngressBackendRef.Path should be compared against an incoming request path.
+type IngressPathMatchType string
+
+const (
+	// PathMatchPrefix matches when the request path starts with Path, split on '/' boundaries, as defined by the
+	// Prefix PathType.
+	PathMatchPrefix IngressPathMatchType = "Prefix"
+
+	// PathMatchExact matches when the request path is identical to Path, as defined by the Exact PathType.
+	PathMatchExact IngressPathMatchType = "Exact"
+
+	// PathMatchRegex matches when the request path satisfies the regular expression in Path. It is used for the
+	// ImplementationSpecific PathType (whose matching semantics are otherwise up to the ingress controller) and for
+	// Ingresses created before PathType was introduced.
+	PathMatchRegex IngressPathMatchType = "Regex"
+)
+
+// IngressBackendRef is a normalized reference to a single Ingress rule's backend, independent of whether it was
+// read off a networking.k8s.io/v1 or networking.k8s.io/v1beta1 Ingress.
+type IngressBackendRef struct {
+	// Host is the rule's host, empty for the default backend.
+	Host string
+
+	// Path is the rule's path exactly as written in the Ingress ("/" for the default backend); MatchType says how it
+	// is compared.
+	Path string
+
+	// MatchType describes how Path should be compared against an incoming request path.
+	MatchType IngressPathMatchType
+
+	// ServiceName is the name of the backend Service.
+	ServiceName string
+
+	// ServicePort is the backend Service's port, by name or number.
+	ServicePort intstr.IntOrString
+}
+
+// GetIngressBackends returns the normalized backend references, across all matching Ingresses, whose backend
+// corresponds to the given service.
+func (c Client) GetIngressBackends(meshService service.MeshService) ([]*IngressBackendRef, error) {
+	switch c.GetAPIVersion() {
+	case IngressNetworkingV1:
+		return c.getIngressBackendsV1(meshService)
+
+	case IngressNetworkingV1beta1:
+		return c.getIngressBackendsV1beta1(meshService)
+
+	default:
+		return nil, errUnexpectedAPIVersion
+	}
+}
+
+func (c Client) getIngressBackendsV1(meshService service.MeshService) ([]*IngressBackendRef, error) {
+	ingresses, err := c.GetIngressNetworkingV1(meshService)
+	if err != nil {
+		return nil, err
+	}
+
+	var backends []*IngressBackendRef
+	for _, ingress := range ingresses {
+		if backend := ingress.Spec.DefaultBackend; backend != nil && backend.Service != nil && backend.Service.Name == meshService.Name {
+			backends = append(backends, &IngressBackendRef{
+				Path:        "/",
+				MatchType:   PathMatchPrefix,
+				ServiceName: backend.Service.Name,
+				ServicePort: servicePortV1(backend.Service.Port),
+			})
+		}
+
+		for _, rule := range ingress.Spec.Rules {
+			for _, path := range rule.HTTP.Paths {
+				if path.Backend.Service == nil || path.Backend.Service.Name != meshService.Name {
+					continue
+				}
+				backends = append(backends, &IngressBackendRef{
+					Host:        rule.Host,
+					Path:        path.Path,
+					MatchType:   pathMatchTypeV1(path.PathType),
+					ServiceName: path.Backend.Service.Name,
+					ServicePort: servicePortV1(path.Backend.Service.Port),
+				})
+			}
+		}
+	}
+	return backends, nil
+}
+
+func (c Client) getIngressBackendsV1beta1(meshService service.MeshService) ([]*IngressBackendRef, error) {
+	ingresses, err := c.GetIngressNetworkingV1beta1(meshService)
+	if err != nil {
+		return nil, err
+	}
+
+	var backends []*IngressBackendRef
+	for _, ingress := range ingresses {
+		if backend := ingress.Spec.Backend; backend != nil && backend.ServiceName == meshService.Name {
+			backends = append(backends, &IngressBackendRef{
+				Path:        "/",
+				MatchType:   PathMatchPrefix,
+				ServiceName: backend.ServiceName,
+				ServicePort: backend.ServicePort,
+			})
+		}
+
+		for _, rule := range ingress.Spec.Rules {
+			for _, path := range rule.HTTP.Paths {
+				if path.Backend.ServiceName != meshService.Name {
+					continue
+				}
+				backends = append(backends, &IngressBackendRef{
+					Host:        rule.Host,
+					Path:        path.Path,
+					MatchType:   pathMatchTypeV1beta1(path.PathType),
+					ServiceName: path.Backend.ServiceName,
+					ServicePort: path.Backend.ServicePort,
+				})
+			}
+		}
+	}
+	return backends, nil
+}
+
+// pathMatchTypeV1 translates a networking.k8s.io/v1 PathType into the match semantics OSM uses to build Envoy
+// route configuration: Prefix and Exact map onto the corresponding Envoy match, while ImplementationSpecific (and
+// the unset case, for Ingresses authored before PathType existed) falls back to a regex match on the literal path,
+// mirroring how other ingress controllers treat ImplementationSpecific as controller-defined.
+func pathMatchTypeV1(pathType *networkingV1.PathType) IngressPathMatchType {
+	if pathType == nil {
+		return PathMatchRegex
+	}
+	switch *pathType {
+	case networkingV1.PathTypeExact:
+		return PathMatchExact
+	case networkingV1.PathTypePrefix:
+		return PathMatchPrefix
+	default:
+		return PathMatchRegex
+	}
+}
+
+// pathMatchTypeV1beta1 is the networking.k8s.io/v1beta1 equivalent of pathMatchTypeV1.
+func pathMatchTypeV1beta1(pathType *networkingV1beta1.PathType) IngressPathMatchType {
+	if pathType == nil {
+		return PathMatchRegex
+	}
+	switch *pathType {
+	case networkingV1beta1.PathTypeExact:
+		return PathMatchExact
+	case networkingV1beta1.PathTypePrefix:
+		return PathMatchPrefix
+	default:
+		return PathMatchRegex
+	}
+}
+
+// servicePortV1 normalizes a networking.k8s.io/v1 ServiceBackendPort, which names the port by either name or
+// number, into the intstr.IntOrString representation used by IngressBackendRef.
+func servicePortV1(port networkingV1.ServiceBackendPort) intstr.IntOrString {
+	if port.Name != "" {
+		return intstr.FromString(port.Name)
+	}
+	return intstr.FromInt(int(port.Number))
+}
@@ -0,0 +1,257 @@
+package ingress
+
+import (
+	"testing"
+
+	networkingV1 "k8s.io/api/networking/v1"
+	networkingV1beta1 "k8s.io/api/networking/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/openservicemesh/osm/pkg/service"
+)
+
+func TestPathMatchTypeV1(t *testing.T) {
+	prefix := networkingV1.PathTypePrefix
+	exact := networkingV1.PathTypeExact
+	implementationSpecific := networkingV1.PathTypeImplementationSpecific
+
+	testCases := []struct {
+		name     string
+		pathType *networkingV1.PathType
+		expect   IngressPathMatchType
+	}{
+		{name: "Prefix maps to a prefix match", pathType: &prefix, expect: PathMatchPrefix},
+		{name: "Exact maps to an exact match", pathType: &exact, expect: PathMatchExact},
+		{name: "ImplementationSpecific maps to a regex match", pathType: &implementationSpecific, expect: PathMatchRegex},
+		{name: "unset PathType (pre-PathType Ingresses) maps to a regex match", pathType: nil, expect: PathMatchRegex},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := pathMatchTypeV1(tc.pathType); got != tc.expect {
+				t.Errorf("pathMatchTypeV1() = %v, want %v", got, tc.expect)
+			}
+		})
+	}
+}
+
+func TestPathMatchTypeV1beta1(t *testing.T) {
+	prefix := networkingV1beta1.PathTypePrefix
+	exact := networkingV1beta1.PathTypeExact
+	implementationSpecific := networkingV1beta1.PathTypeImplementationSpecific
+
+	testCases := []struct {
+		name     string
+		pathType *networkingV1beta1.PathType
+		expect   IngressPathMatchType
+	}{
+		{name: "Prefix maps to a prefix match", pathType: &prefix, expect: PathMatchPrefix},
+		{name: "Exact maps to an exact match", pathType: &exact, expect: PathMatchExact},
+		{name: "ImplementationSpecific maps to a regex match", pathType: &implementationSpecific, expect: PathMatchRegex},
+		{name: "unset PathType (pre-PathType Ingresses) maps to a regex match", pathType: nil, expect: PathMatchRegex},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := pathMatchTypeV1beta1(tc.pathType); got != tc.expect {
+				t.Errorf("pathMatchTypeV1beta1() = %v, want %v", got, tc.expect)
+			}
+		})
+	}
+}
+
+func TestGetIngressBackendsV1(t *testing.T) {
+	prefix := networkingV1.PathTypePrefix
+	ingress := &networkingV1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Name: "ing1", Namespace: "ns1"},
+		Spec: networkingV1.IngressSpec{
+			DefaultBackend: &networkingV1.IngressBackend{
+				Service: &networkingV1.IngressServiceBackend{Name: "default-svc", Port: networkingV1.ServiceBackendPort{Number: 80}},
+			},
+			Rules: []networkingV1.IngressRule{
+				{
+					Host: "example.com",
+					IngressRuleValue: networkingV1.IngressRuleValue{
+						HTTP: &networkingV1.HTTPIngressRuleValue{
+							Paths: []networkingV1.HTTPIngressPath{
+								{
+									Path:     "/api",
+									PathType: &prefix,
+									Backend: networkingV1.IngressBackend{
+										Service: &networkingV1.IngressServiceBackend{Name: "api-svc", Port: networkingV1.ServiceBackendPort{Name: "http"}},
+									},
+								},
+								{
+									// A path whose backend points at a different service must not show up in
+									// the results for meshService below.
+									Path: "/other",
+									Backend: networkingV1.IngressBackend{
+										Service: &networkingV1.IngressServiceBackend{Name: "other-svc", Port: networkingV1.ServiceBackendPort{Number: 80}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	cacheStore := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{byBackendServiceIndex: indexIngressByBackendService})
+	if err := cacheStore.Add(ingress); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	c := Client{cache: cacheStore, kubeController: newFakeController("ns1"), apiVersion: IngressNetworkingV1}
+
+	backends, err := c.GetIngressBackends(service.MeshService{Namespace: "ns1", Name: "api-svc"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []*IngressBackendRef{
+		{
+			Host:        "example.com",
+			Path:        "/api",
+			MatchType:   PathMatchPrefix,
+			ServiceName: "api-svc",
+			ServicePort: intstr.FromString("http"),
+		},
+	}
+	if !equalBackendRefs(backends, expected) {
+		t.Errorf("GetIngressBackends() = %+v, want %+v", derefBackendRefs(backends), derefBackendRefs(expected))
+	}
+
+	defaultBackends, err := c.GetIngressBackends(service.MeshService{Namespace: "ns1", Name: "default-svc"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expectedDefault := []*IngressBackendRef{
+		{
+			Path:        "/",
+			MatchType:   PathMatchPrefix,
+			ServiceName: "default-svc",
+			ServicePort: intstr.FromInt(80),
+		},
+	}
+	if !equalBackendRefs(defaultBackends, expectedDefault) {
+		t.Errorf("GetIngressBackends() = %+v, want %+v", derefBackendRefs(defaultBackends), derefBackendRefs(expectedDefault))
+	}
+}
+
+func TestGetIngressBackendsV1beta1(t *testing.T) {
+	prefix := networkingV1beta1.PathTypePrefix
+	ingress := &networkingV1beta1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Name: "ing1", Namespace: "ns1"},
+		Spec: networkingV1beta1.IngressSpec{
+			Backend: &networkingV1beta1.IngressBackend{ServiceName: "default-svc", ServicePort: intstr.FromInt(80)},
+			Rules: []networkingV1beta1.IngressRule{
+				{
+					Host: "example.com",
+					IngressRuleValue: networkingV1beta1.IngressRuleValue{
+						HTTP: &networkingV1beta1.HTTPIngressRuleValue{
+							Paths: []networkingV1beta1.HTTPIngressPath{
+								{
+									Path:     "/api",
+									PathType: &prefix,
+									Backend:  networkingV1beta1.IngressBackend{ServiceName: "api-svc", ServicePort: intstr.FromString("http")},
+								},
+								{
+									// A path whose backend points at a different service must not show up in
+									// the results for meshService below.
+									Path:    "/other",
+									Backend: networkingV1beta1.IngressBackend{ServiceName: "other-svc", ServicePort: intstr.FromInt(80)},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	cacheStore := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{byBackendServiceIndex: indexIngressByBackendService})
+	if err := cacheStore.Add(ingress); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	c := Client{cache: cacheStore, kubeController: newFakeController("ns1"), apiVersion: IngressNetworkingV1beta1}
+
+	backends, err := c.GetIngressBackends(service.MeshService{Namespace: "ns1", Name: "api-svc"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []*IngressBackendRef{
+		{
+			Host:        "example.com",
+			Path:        "/api",
+			MatchType:   PathMatchPrefix,
+			ServiceName: "api-svc",
+			ServicePort: intstr.FromString("http"),
+		},
+	}
+	if !equalBackendRefs(backends, expected) {
+		t.Errorf("GetIngressBackends() = %+v, want %+v", derefBackendRefs(backends), derefBackendRefs(expected))
+	}
+
+	defaultBackends, err := c.GetIngressBackends(service.MeshService{Namespace: "ns1", Name: "default-svc"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expectedDefault := []*IngressBackendRef{
+		{
+			Path:        "/",
+			MatchType:   PathMatchPrefix,
+			ServiceName: "default-svc",
+			ServicePort: intstr.FromInt(80),
+		},
+	}
+	if !equalBackendRefs(defaultBackends, expectedDefault) {
+		t.Errorf("GetIngressBackends() = %+v, want %+v", derefBackendRefs(defaultBackends), derefBackendRefs(expectedDefault))
+	}
+}
+
+// equalBackendRefs compares two []*IngressBackendRef by value, since the callers under test always build exactly
+// one backend per matching path and ordering follows Ingress.Spec.Rules, which is deterministic here.
+func equalBackendRefs(got, want []*IngressBackendRef) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	for i := range got {
+		if *got[i] != *want[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func derefBackendRefs(refs []*IngressBackendRef) []IngressBackendRef {
+	out := make([]IngressBackendRef, len(refs))
+	for i, ref := range refs {
+		out[i] = *ref
+	}
+	return out
+}
+
+func TestServicePortV1(t *testing.T) {
+	testCases := []struct {
+		name   string
+		port   networkingV1.ServiceBackendPort
+		expect string
+	}{
+		{name: "named port", port: networkingV1.ServiceBackendPort{Name: "http"}, expect: "http"},
+		{name: "numbered port", port: networkingV1.ServiceBackendPort{Number: 8080}, expect: "8080"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := servicePortV1(tc.port)
+			if got := result.String(); got != tc.expect {
+				t.Errorf("servicePortV1() = %v, want %v", got, tc.expect)
+			}
+		})
+	}
+}
@@ -15,18 +15,32 @@ import (
 	"github.com/openservicemesh/osm/pkg/service"
 )
 
+// ingressClassAnnotation is the legacy annotation networking.k8s.io/v1beta1 Ingresses use to select an ingress
+// controller. networking.k8s.io/v1 Ingresses use spec.IngressClassName instead, but this annotation is honored
+// there too for backwards compatibility, mirroring the apiserver's own handling of the field.
+const ingressClassAnnotation = "kubernetes.io/ingress.class"
+
+// byBackendServiceIndex is the name of the cache.Indexer that maps a backend Service (namespace/name) to the
+// Ingress resources referencing it, so GetIngressNetworkingV1/GetIngressNetworkingV1beta1 do not need to scan every
+// Ingress in the cache on every call.
+const byBackendServiceIndex = "byBackendService"
+
 // NewIngressClient implements ingress.Monitor and creates the Kubernetes client to monitor Ingress resources.
 func NewIngressClient(kubeClient kubernetes.Interface, kubeController k8s.Controller, stop chan struct{}, cfg configurator.Configurator) (Monitor, error) {
-	// TODO(#2798): Dynamically retrieve configured version
-	// Currently, since only networking.k8s.io/v1beta1 is supported, hardcode this.
-	requestedAPIVersion := IngressNetworkingV1beta1
+	requestedAPIVersion, err := discoverSupportedIngressAPIVersion(kubeClient)
+	if err != nil {
+		log.Error().Err(err).Msg("Could not discover a supported Ingress API version from the apiserver")
+		return nil, err
+	}
 
 	var informer cache.SharedIndexInformer
+	var ingressClassInformer cache.SharedIndexInformer
 	informerFactory := informers.NewSharedInformerFactory(kubeClient, k8s.DefaultKubeEventResyncInterval)
 
 	switch requestedAPIVersion {
 	case IngressNetworkingV1:
 		informer = informerFactory.Networking().V1().Ingresses().Informer()
+		ingressClassInformer = informerFactory.Networking().V1().IngressClasses().Informer()
 
 	case IngressNetworkingV1beta1:
 		informer = informerFactory.Networking().V1beta1().Ingresses().Informer()
@@ -35,12 +49,22 @@ func NewIngressClient(kubeClient kubernetes.Interface, kubeController k8s.Contro
 		return nil, ErrUnsupportedAPIVersion
 	}
 
+	if err := informer.AddIndexers(cache.Indexers{byBackendServiceIndex: indexIngressByBackendService}); err != nil {
+		return nil, err
+	}
+
 	client := Client{
-		informer:       informer,
-		cache:          informer.GetStore(),
-		cacheSynced:    make(chan interface{}),
-		kubeController: kubeController,
-		apiVersion:     requestedAPIVersion,
+		informer:             informer,
+		cache:                informer.GetStore(),
+		ingressClassInformer: ingressClassInformer,
+		cacheSynced:          make(chan interface{}),
+		kubeClient:           kubeClient,
+		kubeController:       kubeController,
+		apiVersion:           requestedAPIVersion,
+		ingressClassName:     cfg.GetOSMIngressClass(),
+	}
+	if ingressClassInformer != nil {
+		client.ingressClassCache = ingressClassInformer.GetStore()
 	}
 
 	shouldObserve := func(obj interface{}) bool {
@@ -55,14 +79,43 @@ func NewIngressClient(kubeClient kubernetes.Interface, kubeController k8s.Contro
 	}
 	informer.AddEventHandler(k8s.GetKubernetesEventHandlers("Ingress", "Kubernetes", shouldObserve, ingrEventTypes))
 
+	if ingressClassInformer != nil {
+		// IngressClass is cluster-scoped, so there is no namespace to filter on.
+		alwaysObserve := func(interface{}) bool { return true }
+		classEventTypes := k8s.EventTypes{
+			Add:    announcements.IngressClassAdded,
+			Update: announcements.IngressClassUpdated,
+			Delete: announcements.IngressClassDeleted,
+		}
+		ingressClassInformer.AddEventHandler(k8s.GetKubernetesEventHandlers("IngressClass", "Kubernetes", alwaysObserve, classEventTypes))
+	}
+
 	if err := client.run(stop); err != nil {
 		log.Error().Err(err).Msg("Could not start Kubernetes Ingress client")
 		return nil, err
 	}
 
+	go newStatusSyncer(client, cfg).run(stop)
+
 	return client, nil
 }
 
+// discoverSupportedIngressAPIVersion queries the apiserver for the Ingress API versions it serves and selects
+// networking.k8s.io/v1, falling back to networking.k8s.io/v1beta1 on older clusters that do not serve v1. An error
+// is returned when neither version is served.
+func discoverSupportedIngressAPIVersion(kubeClient kubernetes.Interface) (APIVersion, error) {
+	if _, err := kubeClient.Discovery().ServerResourcesForGroupVersion(string(IngressNetworkingV1)); err == nil {
+		return IngressNetworkingV1, nil
+	}
+
+	log.Debug().Msgf("%s is not served by the apiserver, falling back to %s", IngressNetworkingV1, IngressNetworkingV1beta1)
+	if _, err := kubeClient.Discovery().ServerResourcesForGroupVersion(string(IngressNetworkingV1beta1)); err == nil {
+		return IngressNetworkingV1beta1, nil
+	}
+
+	return "", ErrUnsupportedAPIVersion
+}
+
 // run executes informer collection.
 func (c *Client) run(stop <-chan struct{}) error {
 	log.Info().Msg("Ingress client started")
@@ -71,9 +124,22 @@ func (c *Client) run(stop <-chan struct{}) error {
 		return errInitInformers
 	}
 
-	go c.informer.Run(stop)
-	log.Info().Msgf("Waiting for Ingress informer cache sync")
-	if !cache.WaitForCacheSync(stop, c.informer.HasSynced) {
+	informers := map[string]cache.SharedIndexInformer{
+		"Ingress": c.informer,
+	}
+	if c.ingressClassInformer != nil {
+		informers["IngressClass"] = c.ingressClassInformer
+	}
+
+	var hasSynced []cache.InformerSynced
+	for name, informer := range informers {
+		log.Info().Msgf("Starting %s informer", name)
+		go informer.Run(stop)
+		hasSynced = append(hasSynced, informer.HasSynced)
+	}
+
+	log.Info().Msgf("Waiting for Ingress informer caches to sync")
+	if !cache.WaitForCacheSync(stop, hasSynced...) {
 		return errSyncingCaches
 	}
 
@@ -89,15 +155,59 @@ func (c Client) GetAPIVersion() APIVersion {
 	return c.apiVersion
 }
 
+// isOSMIngressV1beta1 returns true if the given networking.k8s.io/v1beta1 Ingress is owned by this OSM instance,
+// i.e. its ingress class annotation matches the class OSM is configured to observe, or no class was configured.
+func (c Client) isOSMIngressV1beta1(ingress *networkingV1beta1.Ingress) bool {
+	if c.ingressClassName == "" {
+		return true
+	}
+	return ingress.Annotations[ingressClassAnnotation] == c.ingressClassName
+}
+
+// isOSMIngressV1 returns true if the given networking.k8s.io/v1 Ingress is owned by this OSM instance, i.e. its
+// spec.IngressClassName (or, failing that, its legacy ingress class annotation) matches the class OSM is configured
+// to observe, or no class was configured.
+func (c Client) isOSMIngressV1(ingress *networkingV1.Ingress) bool {
+	if c.ingressClassName == "" {
+		return true
+	}
+	if ingress.Spec.IngressClassName != nil {
+		return *ingress.Spec.IngressClassName == c.ingressClassName
+	}
+	return ingress.Annotations[ingressClassAnnotation] == c.ingressClassName
+}
+
+// isOSMIngress reports whether obj is an Ingress this OSM instance owns - i.e. it is in a monitored namespace and
+// matches the configured ingress class - regardless of its API version. Consumers that read Ingress objects
+// straight out of c.cache (which holds every Ingress in the cluster, not just OSM's) must run this check before
+// acting on one, the same way GetIngressNetworkingV1/GetIngressNetworkingV1beta1 already do.
+func (c Client) isOSMIngress(obj interface{}) bool {
+	switch ingress := obj.(type) {
+	case *networkingV1.Ingress:
+		return c.kubeController.IsMonitoredNamespace(ingress.Namespace) && c.isOSMIngressV1(ingress)
+
+	case *networkingV1beta1.Ingress:
+		return c.kubeController.IsMonitoredNamespace(ingress.Namespace) && c.isOSMIngressV1beta1(ingress)
+
+	default:
+		return false
+	}
+}
+
 // GetIngressNetworkingV1beta1 returns the networking.k8s.io/v1beta1 ingress resources whose backends correspond to the service
 func (c Client) GetIngressNetworkingV1beta1(meshService service.MeshService) ([]*networkingV1beta1.Ingress, error) {
 	if c.GetAPIVersion() != IngressNetworkingV1beta1 {
 		return nil, errUnexpectedAPIVersion
 	}
 
+	objs, err := c.byBackendService(meshService)
+	if err != nil {
+		return nil, err
+	}
+
 	var ingressResources []*networkingV1beta1.Ingress
-	for _, ingressInterface := range c.cache.List() {
-		ingress, ok := ingressInterface.(*networkingV1beta1.Ingress)
+	for _, obj := range objs {
+		ingress, ok := obj.(*networkingV1beta1.Ingress)
 		if !ok {
 			log.Error().Msg("Failed type assertion for Ingress in ingress cache")
 			continue
@@ -108,26 +218,12 @@ func (c Client) GetIngressNetworkingV1beta1(meshService service.MeshService) ([]
 			continue
 		}
 
-		// Check if the ingress resource belongs to the same namespace as the service
-		if ingress.Namespace != meshService.Namespace {
-			// The ingress resource does not belong to the namespace of the service
-			continue
-		}
-		if backend := ingress.Spec.Backend; backend != nil && backend.ServiceName == meshService.Name {
-			// Default backend service
-			ingressResources = append(ingressResources, ingress)
+		// Only consider Ingresses OSM owns, so OSM can coexist with other ingress controllers in the same cluster
+		if !c.isOSMIngressV1beta1(ingress) {
 			continue
 		}
 
-	ingressRule:
-		for _, rule := range ingress.Spec.Rules {
-			for _, path := range rule.HTTP.Paths {
-				if path.Backend.ServiceName == meshService.Name {
-					ingressResources = append(ingressResources, ingress)
-					break ingressRule
-				}
-			}
-		}
+		ingressResources = append(ingressResources, ingress)
 	}
 	return ingressResources, nil
 }
@@ -138,9 +234,14 @@ func (c Client) GetIngressNetworkingV1(meshService service.MeshService) ([]*netw
 		return nil, errUnexpectedAPIVersion
 	}
 
+	objs, err := c.byBackendService(meshService)
+	if err != nil {
+		return nil, err
+	}
+
 	var ingressResources []*networkingV1.Ingress
-	for _, ingressInterface := range c.cache.List() {
-		ingress, ok := ingressInterface.(*networkingV1.Ingress)
+	for _, obj := range objs {
+		ingress, ok := obj.(*networkingV1.Ingress)
 		if !ok {
 			log.Error().Msg("Failed type assertion for Ingress in ingress cache")
 			continue
@@ -151,26 +252,64 @@ func (c Client) GetIngressNetworkingV1(meshService service.MeshService) ([]*netw
 			continue
 		}
 
-		// Check if the ingress resource belongs to the same namespace as the service
-		if ingress.Namespace != meshService.Namespace {
-			// The ingress resource does not belong to the namespace of the service
-			continue
-		}
-		if backend := ingress.Spec.DefaultBackend; backend != nil && backend.Service.Name == meshService.Name {
-			// Default backend service
-			ingressResources = append(ingressResources, ingress)
+		// Only consider Ingresses OSM owns, so OSM can coexist with other ingress controllers in the same cluster
+		if !c.isOSMIngressV1(ingress) {
 			continue
 		}
 
-	ingressRule:
+		ingressResources = append(ingressResources, ingress)
+	}
+	return ingressResources, nil
+}
+
+// byBackendService looks up the Ingress resources in the given service's namespace that reference it as a backend,
+// via the byBackendServiceIndex indexer, instead of scanning every Ingress in the cache.
+func (c Client) byBackendService(meshService service.MeshService) ([]interface{}, error) {
+	indexer, ok := c.cache.(cache.Indexer)
+	if !ok {
+		return nil, errInitInformers
+	}
+	return indexer.ByIndex(byBackendServiceIndex, backendServiceIndexKey(meshService.Namespace, meshService.Name))
+}
+
+// backendServiceIndexKey is the byBackendServiceIndex key for a given backend Service.
+func backendServiceIndexKey(namespace, serviceName string) string {
+	return namespace + "/" + serviceName
+}
+
+// indexIngressByBackendService is the cache.IndexFunc backing byBackendServiceIndex: it extracts the
+// (namespace, service-name) tuple of every backend an Ingress references, from its default backend and every
+// rule's paths, for both supported Ingress API versions.
+func indexIngressByBackendService(obj interface{}) ([]string, error) {
+	keys := make(map[string]struct{})
+
+	switch ingress := obj.(type) {
+	case *networkingV1.Ingress:
+		if backend := ingress.Spec.DefaultBackend; backend != nil && backend.Service != nil {
+			keys[backendServiceIndexKey(ingress.Namespace, backend.Service.Name)] = struct{}{}
+		}
 		for _, rule := range ingress.Spec.Rules {
 			for _, path := range rule.HTTP.Paths {
-				if path.Backend.Service.Name == meshService.Name {
-					ingressResources = append(ingressResources, ingress)
-					break ingressRule
+				if path.Backend.Service != nil {
+					keys[backendServiceIndexKey(ingress.Namespace, path.Backend.Service.Name)] = struct{}{}
 				}
 			}
 		}
+
+	case *networkingV1beta1.Ingress:
+		if backend := ingress.Spec.Backend; backend != nil {
+			keys[backendServiceIndexKey(ingress.Namespace, backend.ServiceName)] = struct{}{}
+		}
+		for _, rule := range ingress.Spec.Rules {
+			for _, path := range rule.HTTP.Paths {
+				keys[backendServiceIndexKey(ingress.Namespace, path.Backend.ServiceName)] = struct{}{}
+			}
+		}
 	}
-	return ingressResources, nil
+
+	indexKeys := make([]string, 0, len(keys))
+	for key := range keys {
+		indexKeys = append(indexKeys, key)
+	}
+	return indexKeys, nil
 }
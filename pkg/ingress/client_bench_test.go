@@ -0,0 +1,91 @@
+package ingress
+
+import (
+	"fmt"
+	"testing"
+
+	networkingV1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/openservicemesh/osm/pkg/service"
+)
+
+// newBenchmarkIngresses builds n networking.k8s.io/v1 Ingresses, each with a single rule pointing at its own
+// backend Service, so that at most one Ingress ever matches a given meshService.
+func newBenchmarkIngresses(n int) []*networkingV1.Ingress {
+	ingresses := make([]*networkingV1.Ingress, 0, n)
+	for i := 0; i < n; i++ {
+		svcName := fmt.Sprintf("svc-%d", i)
+		ingresses = append(ingresses, &networkingV1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("ingress-%d", i), Namespace: "default"},
+			Spec: networkingV1.IngressSpec{
+				Rules: []networkingV1.IngressRule{
+					{
+						IngressRuleValue: networkingV1.IngressRuleValue{
+							HTTP: &networkingV1.HTTPIngressRuleValue{
+								Paths: []networkingV1.HTTPIngressPath{
+									{Backend: networkingV1.IngressBackend{Service: &networkingV1.IngressServiceBackend{Name: svcName}}},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+	}
+	return ingresses
+}
+
+// BenchmarkByBackendServiceIndex measures the O(k) indexed lookup GetIngressNetworkingV1/GetIngressNetworkingV1beta1
+// now use, on a cache of 10k Ingresses.
+func BenchmarkByBackendServiceIndex(b *testing.B) {
+	const numIngresses = 10000
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{byBackendServiceIndex: indexIngressByBackendService})
+	for _, ingress := range newBenchmarkIngresses(numIngresses) {
+		if err := indexer.Add(ingress); err != nil {
+			b.Fatal(err)
+		}
+	}
+	client := Client{cache: indexer}
+	meshService := service.MeshService{Namespace: "default", Name: "svc-9999"}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := client.byBackendService(meshService); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkLinearBackendServiceScan measures the O(n) cache.List() scan GetIngressNetworkingV1 used before the
+// byBackendServiceIndex indexer existed, on the same 10k-Ingress cache, for comparison against
+// BenchmarkByBackendServiceIndex.
+func BenchmarkLinearBackendServiceScan(b *testing.B) {
+	const numIngresses = 10000
+	store := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	for _, ingress := range newBenchmarkIngresses(numIngresses) {
+		if err := store.Add(ingress); err != nil {
+			b.Fatal(err)
+		}
+	}
+	meshService := service.MeshService{Namespace: "default", Name: "svc-9999"}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var matched []*networkingV1.Ingress
+		for _, obj := range store.List() {
+			ingress := obj.(*networkingV1.Ingress)
+			if ingress.Namespace != meshService.Namespace {
+				continue
+			}
+			for _, rule := range ingress.Spec.Rules {
+				for _, path := range rule.HTTP.Paths {
+					if path.Backend.Service != nil && path.Backend.Service.Name == meshService.Name {
+						matched = append(matched, ingress)
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,211 @@
+package ingress
+
+import (
+	"testing"
+
+	networkingV1 "k8s.io/api/networking/v1"
+	networkingV1beta1 "k8s.io/api/networking/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestIsOSMIngressV1(t *testing.T) {
+	osmClass := "osm"
+
+	testCases := []struct {
+		name             string
+		ingressClassName string
+		ingress          *networkingV1.Ingress
+		expect           bool
+	}{
+		{
+			name:             "no ingress class configured observes every Ingress",
+			ingressClassName: "",
+			ingress:          &networkingV1.Ingress{},
+			expect:           true,
+		},
+		{
+			name:             "spec.IngressClassName matching the configured class is observed",
+			ingressClassName: osmClass,
+			ingress:          &networkingV1.Ingress{Spec: networkingV1.IngressSpec{IngressClassName: &osmClass}},
+			expect:           true,
+		},
+		{
+			name:             "spec.IngressClassName for a different class is not observed",
+			ingressClassName: osmClass,
+			ingress: func() *networkingV1.Ingress {
+				other := "nginx"
+				return &networkingV1.Ingress{Spec: networkingV1.IngressSpec{IngressClassName: &other}}
+			}(),
+			expect: false,
+		},
+		{
+			name:             "legacy annotation is honored when spec.IngressClassName is unset",
+			ingressClassName: osmClass,
+			ingress: &networkingV1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{ingressClassAnnotation: osmClass}},
+			},
+			expect: true,
+		},
+		{
+			name:             "no class at all is not observed once a class is configured",
+			ingressClassName: osmClass,
+			ingress:          &networkingV1.Ingress{},
+			expect:           false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := Client{ingressClassName: tc.ingressClassName}
+			if got := c.isOSMIngressV1(tc.ingress); got != tc.expect {
+				t.Errorf("isOSMIngressV1() = %v, want %v", got, tc.expect)
+			}
+		})
+	}
+}
+
+func TestIsOSMIngressV1beta1(t *testing.T) {
+	osmClass := "osm"
+
+	testCases := []struct {
+		name             string
+		ingressClassName string
+		ingress          *networkingV1beta1.Ingress
+		expect           bool
+	}{
+		{
+			name:             "no ingress class configured observes every Ingress",
+			ingressClassName: "",
+			ingress:          &networkingV1beta1.Ingress{},
+			expect:           true,
+		},
+		{
+			name:             "annotation matching the configured class is observed",
+			ingressClassName: osmClass,
+			ingress: &networkingV1beta1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{ingressClassAnnotation: osmClass}},
+			},
+			expect: true,
+		},
+		{
+			name:             "annotation for a different class is not observed",
+			ingressClassName: osmClass,
+			ingress: &networkingV1beta1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{ingressClassAnnotation: "nginx"}},
+			},
+			expect: false,
+		},
+		{
+			name:             "no annotation at all is not observed once a class is configured",
+			ingressClassName: osmClass,
+			ingress:          &networkingV1beta1.Ingress{},
+			expect:           false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := Client{ingressClassName: tc.ingressClassName}
+			if got := c.isOSMIngressV1beta1(tc.ingress); got != tc.expect {
+				t.Errorf("isOSMIngressV1beta1() = %v, want %v", got, tc.expect)
+			}
+		})
+	}
+}
+
+func TestIsOSMIngress(t *testing.T) {
+	osmClass := "osm"
+
+	testCases := []struct {
+		name   string
+		obj    interface{}
+		expect bool
+	}{
+		{
+			name:   "a v1 Ingress in a monitored namespace with a matching class is owned",
+			obj:    &networkingV1.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1"}, Spec: networkingV1.IngressSpec{IngressClassName: &osmClass}},
+			expect: true,
+		},
+		{
+			name:   "a v1 Ingress outside every monitored namespace is not owned",
+			obj:    &networkingV1.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "unmonitored"}, Spec: networkingV1.IngressSpec{IngressClassName: &osmClass}},
+			expect: false,
+		},
+		{
+			name: "a v1beta1 Ingress in a monitored namespace with a matching annotation is owned",
+			obj: &networkingV1beta1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Annotations: map[string]string{ingressClassAnnotation: osmClass}},
+			},
+			expect: true,
+		},
+		{
+			name:   "a v1 Ingress with no ingress class is not owned",
+			obj:    &networkingV1.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1"}},
+			expect: false,
+		},
+		{
+			name:   "an unrecognized type is never owned",
+			obj:    &networkingV1.IngressClass{},
+			expect: false,
+		},
+	}
+
+	c := Client{ingressClassName: osmClass, kubeController: newFakeController("ns1")}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := c.isOSMIngress(tc.obj); got != tc.expect {
+				t.Errorf("isOSMIngress() = %v, want %v", got, tc.expect)
+			}
+		})
+	}
+}
+
+func TestDiscoverSupportedIngressAPIVersion(t *testing.T) {
+	testCases := []struct {
+		name          string
+		resources     []*metav1.APIResourceList
+		expectVersion APIVersion
+		expectErr     bool
+	}{
+		{
+			name: "prefers networking.k8s.io/v1 when the apiserver serves both",
+			resources: []*metav1.APIResourceList{
+				{GroupVersion: string(IngressNetworkingV1)},
+				{GroupVersion: string(IngressNetworkingV1beta1)},
+			},
+			expectVersion: IngressNetworkingV1,
+		},
+		{
+			name:          "falls back to networking.k8s.io/v1beta1 when v1 is not served",
+			resources:     []*metav1.APIResourceList{{GroupVersion: string(IngressNetworkingV1beta1)}},
+			expectVersion: IngressNetworkingV1beta1,
+		},
+		{
+			name:      "errors when neither version is served",
+			resources: nil,
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			kubeClient := fake.NewSimpleClientset()
+			kubeClient.Resources = tc.resources
+
+			version, err := discoverSupportedIngressAPIVersion(kubeClient)
+			if tc.expectErr {
+				if err == nil {
+					t.Fatal("expected an error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if version != tc.expectVersion {
+				t.Errorf("got API version %v, want %v", version, tc.expectVersion)
+			}
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package ingress
+
+import "errors"
+
+var (
+	errInitInformers         = errors.New("error initializing Ingress informers")
+	errSyncingCaches         = errors.New("error waiting for Ingress informer caches to sync")
+	errUnexpectedAPIVersion  = errors.New("unexpected Ingress API version requested of this client")
+	errUnexpectedIngressType = errors.New("expected a networking.k8s.io/v1 or networking.k8s.io/v1beta1 Ingress")
+
+	// ErrUnsupportedAPIVersion is returned when the apiserver does not serve any Ingress API version OSM understands.
+	ErrUnsupportedAPIVersion = errors.New("the apiserver does not serve a supported networking.k8s.io Ingress API version")
+)
@@ -0,0 +1,5 @@
+package ingress
+
+import "github.com/openservicemesh/osm/pkg/logger"
+
+var log = logger.New("kubernetes/ingress")
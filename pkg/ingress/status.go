@@ -0,0 +1,51 @@
+package ingress
+
+import (
+	"context"
+	"reflect"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingV1 "k8s.io/api/networking/v1"
+	networkingV1beta1 "k8s.io/api/networking/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// UpdateIngressStatus patches the given Ingress's status.loadBalancer.ingress field with addr. It is a no-op when
+// the Ingress's current status already matches addr, so callers can invoke it freely from a watch loop without
+// hot-looping on their own status writes. Both networking.k8s.io/v1 and networking.k8s.io/v1beta1 Ingresses are
+// supported; both versions' IngressStatus embed the same corev1.LoadBalancerStatus, so no per-version conversion is
+// needed.
+func (c Client) UpdateIngressStatus(ing metav1.Object, addr []corev1.LoadBalancerIngress) error {
+	switch typed := ing.(type) {
+	case *networkingV1.Ingress:
+		return c.updateIngressStatusV1(typed, addr)
+
+	case *networkingV1beta1.Ingress:
+		return c.updateIngressStatusV1beta1(typed, addr)
+
+	default:
+		return errUnexpectedIngressType
+	}
+}
+
+func (c Client) updateIngressStatusV1(ing *networkingV1.Ingress, addr []corev1.LoadBalancerIngress) error {
+	if reflect.DeepEqual(ing.Status.LoadBalancer.Ingress, addr) {
+		return nil
+	}
+
+	newIng := ing.DeepCopy()
+	newIng.Status.LoadBalancer.Ingress = addr
+	_, err := c.kubeClient.NetworkingV1().Ingresses(newIng.Namespace).UpdateStatus(context.Background(), newIng, metav1.UpdateOptions{})
+	return err
+}
+
+func (c Client) updateIngressStatusV1beta1(ing *networkingV1beta1.Ingress, addr []corev1.LoadBalancerIngress) error {
+	if reflect.DeepEqual(ing.Status.LoadBalancer.Ingress, addr) {
+		return nil
+	}
+
+	newIng := ing.DeepCopy()
+	newIng.Status.LoadBalancer.Ingress = addr
+	_, err := c.kubeClient.NetworkingV1beta1().Ingresses(newIng.Namespace).UpdateStatus(context.Background(), newIng, metav1.UpdateOptions{})
+	return err
+}
@@ -0,0 +1,147 @@
+package ingress
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/openservicemesh/osm/pkg/configurator"
+)
+
+// statusSyncInterval is how often every matched Ingress is re-enqueued for a status check, independent of whether
+// it was added, updated, or deleted since the last pass. This catches OSM's own gateway address changing without
+// relying on a watch event against the Ingress itself.
+const statusSyncInterval = 60 * time.Second
+
+// statusSyncer keeps status.loadBalancer.ingress on every OSM-owned Ingress in sync with the externally reachable
+// address of OSM's ingress gateway, the same way Traefik's clientWrapper.UpdateIngressStatus does for its own
+// ingress controller.
+type statusSyncer struct {
+	client Client
+	cfg    configurator.Configurator
+	queue  workqueue.RateLimitingInterface
+}
+
+// newStatusSyncer creates a statusSyncer for the given Client.
+func newStatusSyncer(client Client, cfg configurator.Configurator) *statusSyncer {
+	return &statusSyncer{
+		client: client,
+		cfg:    cfg,
+		queue:  workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "ingress_status"),
+	}
+}
+
+// run starts the status-sync control loop. It blocks until stop is closed.
+func (s *statusSyncer) run(stop <-chan struct{}) {
+	defer s.queue.ShutDown()
+
+	log.Info().Msg("Starting Ingress status-sync controller")
+	go wait.Until(s.enqueueAll, statusSyncInterval, stop)
+	go s.watchAndEnqueue(stop)
+	go wait.Until(s.worker, time.Second, stop)
+
+	<-stop
+}
+
+// watchAndEnqueue re-queues an Ingress as soon as it is added or updated, so its status does not have to wait for
+// the next periodic enqueueAll pass.
+func (s *statusSyncer) watchAndEnqueue(stop <-chan struct{}) {
+	events, err := s.client.WatchAll(nil, stop)
+	if err != nil {
+		log.Error().Err(err).Msg("Could not subscribe to Ingress changes for status sync")
+		return
+	}
+
+	for obj := range events {
+		key, err := cache.MetaNamespaceKeyFunc(obj)
+		if err != nil {
+			log.Error().Err(err).Msg("Could not compute cache key for Ingress while syncing status")
+			continue
+		}
+		s.queue.Add(key)
+	}
+}
+
+func (s *statusSyncer) worker() {
+	for s.processNextItem() {
+	}
+}
+
+func (s *statusSyncer) processNextItem() bool {
+	key, shutdown := s.queue.Get()
+	if shutdown {
+		return false
+	}
+	defer s.queue.Done(key)
+
+	if err := s.sync(key.(string)); err != nil {
+		log.Error().Err(err).Msgf("Error syncing Ingress status for %s, retrying", key)
+		s.queue.AddRateLimited(key)
+		return true
+	}
+
+	s.queue.Forget(key)
+	return true
+}
+
+// enqueueAll re-queues every Ingress currently in the informer cache.
+func (s *statusSyncer) enqueueAll() {
+	for _, key := range s.client.cache.ListKeys() {
+		s.queue.Add(key)
+	}
+}
+
+func (s *statusSyncer) sync(key string) error {
+	item, exists, err := s.client.cache.GetByKey(key)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		// The Ingress was deleted; there is nothing left to patch.
+		return nil
+	}
+
+	// c.cache holds every Ingress in the cluster, including ones owned by other ingress controllers. Only patch
+	// status on Ingresses this OSM instance actually owns, or OSM will stomp on their status with its own gateway
+	// address.
+	if !s.client.isOSMIngress(item) {
+		return nil
+	}
+
+	ing, ok := item.(metav1.Object)
+	if !ok {
+		return errUnexpectedIngressType
+	}
+
+	addr, err := s.gatewayAddress()
+	if err != nil {
+		return err
+	}
+
+	return s.client.UpdateIngressStatus(ing, addr)
+}
+
+// gatewayAddress resolves the externally reachable address OSM's ingress gateway is running behind: a static
+// address list configured via configurator.Configurator takes precedence, otherwise the gateway Service's own
+// LoadBalancer status is used.
+func (s *statusSyncer) gatewayAddress() ([]corev1.LoadBalancerIngress, error) {
+	if addrs := s.cfg.GetIngressGatewayAddresses(); len(addrs) > 0 {
+		lbIngress := make([]corev1.LoadBalancerIngress, 0, len(addrs))
+		for _, addr := range addrs {
+			lbIngress = append(lbIngress, corev1.LoadBalancerIngress{IP: addr})
+		}
+		return lbIngress, nil
+	}
+
+	svc, err := s.client.kubeClient.CoreV1().Services(s.cfg.GetOSMNamespace()).Get(context.Background(), s.cfg.GetIngressGatewayServiceName(), metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return svc.Status.LoadBalancer.Ingress, nil
+}
@@ -0,0 +1,82 @@
+package ingress
+
+import (
+	"testing"
+
+	networkingV1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/openservicemesh/osm/pkg/configurator"
+)
+
+// fakeConfigurator is a configurator.Configurator that only implements GetIngressGatewayAddresses, the one getter
+// gatewayAddress reads when a static address list is set; every other method panics if called. Embedding the nil
+// interface is what lets this satisfy configurator.Configurator without implementing its full method set.
+type fakeConfigurator struct {
+	configurator.Configurator
+	gatewayAddresses []string
+}
+
+func (f fakeConfigurator) GetIngressGatewayAddresses() []string {
+	return f.gatewayAddresses
+}
+
+// TestStatusSyncerSyncSkipsIngressesNotOwnedByOSM is the regression test for the status-stomping bug: the informer
+// cache holds every Ingress in the cluster, not just OSM's, so sync must not patch status on an Ingress that is
+// outside a monitored namespace (e.g. one owned by another ingress controller).
+func TestStatusSyncerSyncSkipsIngressesNotOwnedByOSM(t *testing.T) {
+	ownedIngress := &networkingV1.Ingress{ObjectMeta: metav1.ObjectMeta{Name: "owned", Namespace: "ns1"}}
+	otherControllersIngress := &networkingV1.Ingress{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "unmonitored"}}
+
+	kubeClient := fake.NewSimpleClientset(ownedIngress, otherControllersIngress)
+
+	cacheStore := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{byBackendServiceIndex: indexIngressByBackendService})
+	for _, ing := range []*networkingV1.Ingress{ownedIngress, otherControllersIngress} {
+		if err := cacheStore.Add(ing); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	c := Client{
+		cache:          cacheStore,
+		kubeClient:     kubeClient,
+		kubeController: newFakeController("ns1"),
+		apiVersion:     IngressNetworkingV1,
+	}
+	s := newStatusSyncer(c, fakeConfigurator{gatewayAddresses: []string{"5.6.7.8"}})
+
+	for _, key := range []string{"ns1/owned", "unmonitored/other"} {
+		if err := s.sync(key); err != nil {
+			t.Fatalf("sync(%s): unexpected error: %s", key, err)
+		}
+	}
+
+	var ownedUpdates int
+	for _, action := range kubeClient.Actions() {
+		if action.GetVerb() != "update" {
+			continue
+		}
+		if action.GetNamespace() != "ns1" {
+			t.Errorf("expected a status update only for the OSM-owned Ingress in ns1, got one in namespace %q", action.GetNamespace())
+			continue
+		}
+		ownedUpdates++
+	}
+	if ownedUpdates != 1 {
+		t.Errorf("expected exactly one status update for the OSM-owned Ingress ns1/owned, got %d", ownedUpdates)
+	}
+}
+
+// TestStatusSyncerSyncIgnoresDeletedIngress covers the exists=false branch: a key that no longer resolves in the
+// cache (the Ingress was deleted) must not be treated as an error.
+func TestStatusSyncerSyncIgnoresDeletedIngress(t *testing.T) {
+	cacheStore := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{byBackendServiceIndex: indexIngressByBackendService})
+	c := Client{cache: cacheStore, kubeController: newFakeController("ns1"), apiVersion: IngressNetworkingV1}
+	s := newStatusSyncer(c, fakeConfigurator{})
+
+	if err := s.sync("ns1/does-not-exist"); err != nil {
+		t.Errorf("unexpected error for a deleted Ingress: %s", err)
+	}
+}
@@ -0,0 +1,71 @@
+package ingress
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingV1 "k8s.io/api/networking/v1"
+	networkingV1beta1 "k8s.io/api/networking/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestUpdateIngressStatusV1NoOpWhenUnchanged(t *testing.T) {
+	addr := []corev1.LoadBalancerIngress{{IP: "1.2.3.4"}}
+	ing := &networkingV1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Name: "ing1", Namespace: "ns1"},
+		Status:     networkingV1.IngressStatus{LoadBalancer: corev1.LoadBalancerStatus{Ingress: addr}},
+	}
+	kubeClient := fake.NewSimpleClientset(ing)
+	c := Client{kubeClient: kubeClient}
+
+	if err := c.UpdateIngressStatus(ing, addr); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, action := range kubeClient.Actions() {
+		if action.GetVerb() == "update" {
+			t.Errorf("expected no update when status already matches, got action %v", action)
+		}
+	}
+}
+
+func TestUpdateIngressStatusV1PatchesWhenChanged(t *testing.T) {
+	ing := &networkingV1.Ingress{ObjectMeta: metav1.ObjectMeta{Name: "ing1", Namespace: "ns1"}}
+	kubeClient := fake.NewSimpleClientset(ing)
+	c := Client{kubeClient: kubeClient}
+
+	addr := []corev1.LoadBalancerIngress{{IP: "1.2.3.4"}}
+	if err := c.UpdateIngressStatus(ing, addr); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	updated, err := kubeClient.NetworkingV1().Ingresses("ns1").Get(context.Background(), "ing1", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(updated.Status.LoadBalancer.Ingress, addr) {
+		t.Errorf("got status.loadBalancer.ingress = %v, want %v", updated.Status.LoadBalancer.Ingress, addr)
+	}
+}
+
+func TestUpdateIngressStatusV1beta1PatchesWhenChanged(t *testing.T) {
+	ing := &networkingV1beta1.Ingress{ObjectMeta: metav1.ObjectMeta{Name: "ing1", Namespace: "ns1"}}
+	kubeClient := fake.NewSimpleClientset(ing)
+	c := Client{kubeClient: kubeClient}
+
+	addr := []corev1.LoadBalancerIngress{{IP: "1.2.3.4"}}
+	if err := c.UpdateIngressStatus(ing, addr); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	updated, err := kubeClient.NetworkingV1beta1().Ingresses("ns1").Get(context.Background(), "ing1", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(updated.Status.LoadBalancer.Ingress, addr) {
+		t.Errorf("got status.loadBalancer.ingress = %v, want %v", updated.Status.LoadBalancer.Ingress, addr)
+	}
+}
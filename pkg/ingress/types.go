@@ -0,0 +1,60 @@
+package ingress
+
+import (
+	networkingV1 "k8s.io/api/networking/v1"
+	networkingV1beta1 "k8s.io/api/networking/v1beta1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+
+	k8s "github.com/openservicemesh/osm/pkg/kubernetes"
+	"github.com/openservicemesh/osm/pkg/service"
+)
+
+// APIVersion is a type alias for the networking.k8s.io Ingress API version served by the cluster this client is watching.
+type APIVersion string
+
+const (
+	// IngressNetworkingV1 is the networking.k8s.io/v1 Ingress API version, available since Kubernetes v1.19.
+	IngressNetworkingV1 APIVersion = "networking.k8s.io/v1"
+
+	// IngressNetworkingV1beta1 is the networking.k8s.io/v1beta1 Ingress API version.
+	IngressNetworkingV1beta1 APIVersion = "networking.k8s.io/v1beta1"
+)
+
+// Client is the type used to represent the Kubernetes client for the Ingress and IngressClass resources.
+type Client struct {
+	informer    cache.SharedIndexInformer
+	cache       cache.Store
+	cacheSynced chan interface{}
+
+	ingressClassInformer cache.SharedIndexInformer
+	ingressClassCache    cache.Store
+
+	kubeClient     kubernetes.Interface
+	kubeController k8s.Controller
+	apiVersion     APIVersion
+
+	// ingressClassName is the value of the Ingress class OSM is configured to observe. An empty value means OSM observes
+	// every Ingress in a monitored namespace, regardless of class.
+	ingressClassName string
+}
+
+// Monitor is the interface for the functionality provided by the resources part of this package.
+type Monitor interface {
+	// GetAPIVersion returns the networking.k8s.io Ingress API version served by the cluster.
+	GetAPIVersion() APIVersion
+
+	// GetIngressNetworkingV1beta1 returns the networking.k8s.io/v1beta1 Ingress resources whose backends correspond to the given service.
+	GetIngressNetworkingV1beta1(service service.MeshService) ([]*networkingV1beta1.Ingress, error)
+
+	// GetIngressNetworkingV1 returns the networking.k8s.io/v1 Ingress resources whose backends correspond to the given service.
+	GetIngressNetworkingV1(service service.MeshService) ([]*networkingV1.Ingress, error)
+
+	// GetIngressBackends returns the normalized backend references, across all matching Ingresses regardless of API
+	// version, whose backend corresponds to the given service.
+	GetIngressBackends(service service.MeshService) ([]*IngressBackendRef, error)
+
+	// WatchAll streams add/update/delete notifications for Ingress and IngressClass resources in the given
+	// namespaces (all namespaces OSM observes, if empty) onto a single channel, closed once stop fires.
+	WatchAll(namespaces []string, stop <-chan struct{}) (<-chan interface{}, error)
+}
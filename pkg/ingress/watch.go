@@ -0,0 +1,144 @@
+package ingress
+
+import (
+	"sync"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	k8s "github.com/openservicemesh/osm/pkg/kubernetes"
+)
+
+// watchAllBufferSize bounds how many undelivered events WatchAll will buffer before it starts dropping events
+// rather than blocking its informer's event handler goroutine.
+const watchAllBufferSize = 256
+
+// WatchAll returns a channel that receives every add/update/delete notification for Ingress (and IngressClass, once
+// dynamic API discovery selects networking.k8s.io/v1) resources in the given namespaces. An empty namespaces slice
+// subscribes to every namespace OSM observes. Events for namespaces OSM does not observe are dropped at the source,
+// regardless of the namespaces filter. Events are deduplicated by resourceVersion, so a caller that processes them
+// one at a time never sees the same object revision twice. The channel is closed once stop fires.
+//
+// The existing announcement bus is unaffected; WatchAll is an additional sink for consumers, such as the
+// status-sync controller, that want to drive a reconcile loop directly off Ingress changes rather than subscribing
+// to announcements.
+func (c Client) WatchAll(namespaces []string, stop <-chan struct{}) (<-chan interface{}, error) {
+	filter := &watchAllFilter{
+		kubeController:      c.kubeController,
+		namespaces:          toNamespaceSet(namespaces),
+		events:              make(chan interface{}, watchAllBufferSize),
+		seenResourceVersion: make(map[string]string),
+	}
+
+	dispatch := func(obj interface{}, isDelete bool) {
+		accessor, err := meta.Accessor(obj)
+		if err != nil {
+			log.Error().Err(err).Msg("Could not access object metadata while watching Ingress resources")
+			return
+		}
+		filter.dispatch(obj, accessor, isDelete)
+	}
+
+	handler := cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(obj interface{}) { dispatch(obj, false) },
+		UpdateFunc: func(_, newObj interface{}) { dispatch(newObj, false) },
+		DeleteFunc: func(obj interface{}) { dispatch(unwrapTombstone(obj), true) },
+	}
+
+	c.informer.AddEventHandler(handler)
+	if c.ingressClassInformer != nil {
+		c.ingressClassInformer.AddEventHandler(handler)
+	}
+
+	go func() {
+		<-stop
+		filter.close()
+	}()
+
+	return filter.events, nil
+}
+
+// toNamespaceSet turns the namespaces slice WatchAll takes into a lookup set.
+func toNamespaceSet(namespaces []string) map[string]bool {
+	set := make(map[string]bool, len(namespaces))
+	for _, ns := range namespaces {
+		set[ns] = true
+	}
+	return set
+}
+
+// unwrapTombstone unwraps the cache.DeletedFinalStateUnknown informers deliver to DeleteFunc when a delete event
+// was missed; meta.Accessor cannot read object metadata off the wrapper itself.
+func unwrapTombstone(obj interface{}) interface{} {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		return tombstone.Obj
+	}
+	return obj
+}
+
+// watchAllFilter holds the concurrency-safe decision logic behind WatchAll: which events to forward, deduplicated
+// by resourceVersion and scoped to monitored/requested namespaces. It is factored out of WatchAll so that decision
+// logic can be exercised directly from tests without standing up real informers.
+type watchAllFilter struct {
+	kubeController k8s.Controller
+	namespaces     map[string]bool
+	events         chan interface{}
+
+	mu                  sync.Mutex
+	closed              bool
+	seenResourceVersion map[string]string
+}
+
+// shouldForward reports whether an event for accessor should be forwarded: its namespace must be monitored by OSM
+// and (if namespaces was non-empty) requested by the caller, and it must not be a duplicate of the last event seen
+// for the same object. It mutates the dedup bookkeeping as a side effect, so it must be called under mu exactly
+// once per event, in delivery order.
+func (f *watchAllFilter) shouldForward(accessor metav1.Object, isDelete bool) bool {
+	ns := accessor.GetNamespace()
+	// IngressClass is cluster-scoped and so is not subject to namespace monitoring.
+	if ns != "" && !f.kubeController.IsMonitoredNamespace(ns) {
+		return false
+	}
+	if len(f.namespaces) > 0 && !f.namespaces[ns] {
+		return false
+	}
+
+	key := string(accessor.GetUID())
+	if isDelete {
+		// Forget the resourceVersion we last saw so a future object reusing the same UID is not mistaken for
+		// a duplicate of the deleted one, and so the map does not grow unboundedly over the process lifetime.
+		delete(f.seenResourceVersion, key)
+		return true
+	}
+	if f.seenResourceVersion[key] == accessor.GetResourceVersion() {
+		return false
+	}
+	f.seenResourceVersion[key] = accessor.GetResourceVersion()
+	return true
+}
+
+// dispatch forwards obj onto f.events if shouldForward accepts it and the filter has not been closed. The dedup
+// check, the closed check, and the channel send all happen under the same lock, so a concurrent close() can never
+// race a send past a closed channel.
+func (f *watchAllFilter) dispatch(obj interface{}, accessor metav1.Object, isDelete bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.closed || !f.shouldForward(accessor, isDelete) {
+		return
+	}
+
+	select {
+	case f.events <- obj:
+	default:
+		log.Error().Msgf("WatchAll event channel is full, dropping event for %s/%s", accessor.GetNamespace(), accessor.GetName())
+	}
+}
+
+func (f *watchAllFilter) close() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.closed = true
+	close(f.events)
+}
@@ -0,0 +1,113 @@
+package ingress
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	k8s "github.com/openservicemesh/osm/pkg/kubernetes"
+)
+
+// fakeController is a k8s.Controller that only implements IsMonitoredNamespace; every other method panics if
+// called, which none of the tests in this package exercise. Embedding the nil interface is what lets this satisfy
+// k8s.Controller without implementing its full method set.
+type fakeController struct {
+	k8s.Controller
+	monitored map[string]bool
+}
+
+func (f fakeController) IsMonitoredNamespace(ns string) bool {
+	return f.monitored[ns]
+}
+
+func newFakeController(monitoredNamespaces ...string) fakeController {
+	monitored := make(map[string]bool, len(monitoredNamespaces))
+	for _, ns := range monitoredNamespaces {
+		monitored[ns] = true
+	}
+	return fakeController{monitored: monitored}
+}
+
+func newTestAccessor(namespace, name, uid, resourceVersion string) metav1.Object {
+	return &metav1.ObjectMeta{Namespace: namespace, Name: name, UID: types.UID(uid), ResourceVersion: resourceVersion}
+}
+
+func TestWatchAllFilterShouldForward(t *testing.T) {
+	testCases := []struct {
+		name     string
+		filter   *watchAllFilter
+		accessor metav1.Object
+		isDelete bool
+		expect   bool
+	}{
+		{
+			name:     "namespaced object in a monitored namespace is forwarded",
+			filter:   &watchAllFilter{kubeController: newFakeController("ns1"), seenResourceVersion: map[string]string{}},
+			accessor: newTestAccessor("ns1", "ing1", "uid1", "1"),
+			expect:   true,
+		},
+		{
+			name:     "namespaced object outside every monitored namespace is dropped",
+			filter:   &watchAllFilter{kubeController: newFakeController("ns1"), seenResourceVersion: map[string]string{}},
+			accessor: newTestAccessor("other-controllers-ns", "ing1", "uid1", "1"),
+			expect:   false,
+		},
+		{
+			name:     "namespaced object outside the caller's requested namespaces is dropped",
+			filter:   &watchAllFilter{kubeController: newFakeController("ns1", "ns2"), namespaces: map[string]bool{"ns1": true}, seenResourceVersion: map[string]string{}},
+			accessor: newTestAccessor("ns2", "ing1", "uid1", "1"),
+			expect:   false,
+		},
+		{
+			name:     "cluster-scoped object (e.g. IngressClass) bypasses namespace monitoring",
+			filter:   &watchAllFilter{kubeController: newFakeController(), seenResourceVersion: map[string]string{}},
+			accessor: newTestAccessor("", "osm", "uid1", "1"),
+			expect:   true,
+		},
+		{
+			name:     "duplicate resourceVersion for the same object is dropped",
+			filter:   &watchAllFilter{kubeController: newFakeController("ns1"), seenResourceVersion: map[string]string{"uid1": "1"}},
+			accessor: newTestAccessor("ns1", "ing1", "uid1", "1"),
+			expect:   false,
+		},
+		{
+			name:     "a new resourceVersion for a previously seen object is forwarded",
+			filter:   &watchAllFilter{kubeController: newFakeController("ns1"), seenResourceVersion: map[string]string{"uid1": "1"}},
+			accessor: newTestAccessor("ns1", "ing1", "uid1", "2"),
+			expect:   true,
+		},
+		{
+			name:     "a delete of a previously seen object is forwarded",
+			filter:   &watchAllFilter{kubeController: newFakeController("ns1"), seenResourceVersion: map[string]string{"uid1": "1"}},
+			accessor: newTestAccessor("ns1", "ing1", "uid1", "1"),
+			isDelete: true,
+			expect:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.filter.shouldForward(tc.accessor, tc.isDelete); got != tc.expect {
+				t.Errorf("shouldForward() = %v, want %v", got, tc.expect)
+			}
+		})
+	}
+}
+
+func TestWatchAllFilterDeleteClearsDedupState(t *testing.T) {
+	filter := &watchAllFilter{kubeController: newFakeController("ns1"), seenResourceVersion: map[string]string{"uid1": "1"}}
+
+	if !filter.shouldForward(newTestAccessor("ns1", "ing1", "uid1", "1"), true) {
+		t.Fatal("expected delete event to be forwarded")
+	}
+	if _, stillTracked := filter.seenResourceVersion["uid1"]; stillTracked {
+		t.Error("expected delete to remove the object's resourceVersion from dedup bookkeeping")
+	}
+
+	// A later add reusing the same UID (e.g. after a resource was recreated) must not be mistaken for a
+	// duplicate of the deleted object just because it happens to reuse resourceVersion "1".
+	if !filter.shouldForward(newTestAccessor("ns1", "ing1", "uid1", "1"), false) {
+		t.Error("expected a recreated object to be forwarded, not treated as a stale duplicate")
+	}
+}